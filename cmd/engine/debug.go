@@ -43,6 +43,8 @@ func setupDebugHandlers(addr string) error {
 		logrus.Debugf("triggered GC from debug endpoint")
 	}))
 
+	m.Handle("/debug/loglevel", http.HandlerFunc(handleLogLevel))
+
 	// setting debugaddr is opt-in. permission is defined by listener address
 	trace.AuthRequest = func(_ *http.Request) (bool, bool) {
 		return true, true
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pelletier/go-toml"
+	sloglogrus "github.com/samber/slog-logrus/v2"
+	"github.com/sirupsen/logrus"
+	lsyslog "github.com/sirupsen/logrus/hooks/syslog"
+	"github.com/urfave/cli"
+)
+
+const (
+	logFormatConsole = "console"
+	logFormatJSON    = "json"
+	logFormatLogfmt  = "logfmt"
+)
+
+var logFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "log-format",
+		Usage: "log output format: console, json or logfmt",
+		Value: logFormatConsole,
+	},
+	cli.StringFlag{
+		Name:  "log-file",
+		Usage: "write logs to the given file instead of stderr",
+	},
+	cli.IntFlag{
+		Name:  "log-file-max-size",
+		Usage: "rotate the log file once it exceeds this many megabytes (0 disables rotation)",
+		Value: 100,
+	},
+	cli.IntFlag{
+		Name:  "log-file-max-backups",
+		Usage: "number of rotated log files to keep",
+		Value: 3,
+	},
+	cli.StringFlag{
+		Name:  "syslog",
+		Usage: `also send logs to syslog; "local" for the local daemon, or e.g. "udp://host:514"`,
+	},
+	cli.StringFlag{
+		Name:  "log-level",
+		Usage: "log level: error, warn, info, debug or trace",
+	},
+	cli.StringSliceFlag{
+		Name:  "log-subsystem-level",
+		Usage: `log level for a subsystem, e.g. "dagql=debug"; can be repeated`,
+	},
+}
+
+// logConfig is read from the [log] table of the engine config file, e.g.:
+//
+//	[log]
+//	format = "json"
+//	file = "/var/log/dagger/engine.log"
+//	level = "info"
+//
+//	[log.subsystems]
+//	dagql = "debug"
+//
+// Flags take precedence over the config file when set.
+type logConfig struct {
+	Format     *string `toml:"format"`
+	File       *string `toml:"file"`
+	MaxSizeMB  *int    `toml:"maxSize"`
+	MaxBackups *int    `toml:"maxBackups"`
+	Syslog     *string `toml:"syslog"`
+	Level      *string `toml:"level"`
+
+	// Subsystems sets the level of slog records with a matching "subsystem"
+	// attribute, overriding Level.
+	Subsystems map[string]string `toml:"subsystems"`
+}
+
+func loadLogConfig(c *cli.Context) (logConfig, error) {
+	var file struct {
+		Log logConfig `toml:"log"`
+	}
+	dt, err := os.ReadFile(c.GlobalString("config"))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return logConfig{}, err
+	default:
+		if err := toml.Unmarshal(dt, &file); err != nil {
+			return logConfig{}, fmt.Errorf("failed to parse log config: %w", err)
+		}
+	}
+	cfg := file.Log
+
+	str := func(dst **string, flag string) {
+		if *dst == nil || c.GlobalIsSet(flag) {
+			v := c.GlobalString(flag)
+			*dst = &v
+		}
+	}
+	num := func(dst **int, flag string) {
+		if *dst == nil || c.GlobalIsSet(flag) {
+			v := c.GlobalInt(flag)
+			*dst = &v
+		}
+	}
+	str(&cfg.Format, "log-format")
+	str(&cfg.File, "log-file")
+	num(&cfg.MaxSizeMB, "log-file-max-size")
+	num(&cfg.MaxBackups, "log-file-max-backups")
+	str(&cfg.Syslog, "syslog")
+	str(&cfg.Level, "log-level")
+	for _, kv := range c.GlobalStringSlice("log-subsystem-level") {
+		name, level, ok := strings.Cut(kv, "=")
+		if !ok {
+			return logConfig{}, fmt.Errorf("invalid subsystem log level %q, expected <subsystem>=<level>", kv)
+		}
+		if cfg.Subsystems == nil {
+			cfg.Subsystems = map[string]string{}
+		}
+		cfg.Subsystems[name] = level
+	}
+	return cfg, nil
+}
+
+// level returns the configured log level, raised to debug or trace if they
+// are enabled in the engine config.
+func (cfg logConfig) level(debug, trace bool) (logrus.Level, error) {
+	level := logrus.InfoLevel
+	if cfg.Level != nil && *cfg.Level != "" {
+		var err error
+		level, err = logrus.ParseLevel(*cfg.Level)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if debug && level < logrus.DebugLevel {
+		level = logrus.DebugLevel
+	}
+	if trace && level < logrus.TraceLevel {
+		level = logrus.TraceLevel
+	}
+	return level, nil
+}
+
+// logLevel is shared between logrus and slog so that changing it at runtime
+// (see handleLogLevel) affects both.
+var logLevel = new(slog.LevelVar)
+
+// subsystemLevels holds the per-subsystem levels applied to slog records.
+var subsystemLevels = &logLevels{levels: map[string]logrus.Level{}}
+
+// setupLogging configures the format and destination of engine logs, and wires
+// up slog to send to Logrus so engine logs using slog also get sent to Cloud.
+func setupLogging(cfg logConfig, level logrus.Level) error {
+	formatter, err := logFormatter(*cfg.Format)
+	if err != nil {
+		return err
+	}
+	logrus.SetFormatter(formatter)
+
+	if *cfg.File != "" {
+		w, err := newRotatingFile(*cfg.File, int64(*cfg.MaxSizeMB)*1024*1024, *cfg.MaxBackups)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		logrus.SetOutput(w)
+	}
+
+	if *cfg.Syslog != "" {
+		hook, err := syslogHook(*cfg.Syslog)
+		if err != nil {
+			return fmt.Errorf("failed to connect to syslog: %w", err)
+		}
+		logrus.AddHook(hook)
+	}
+
+	setLogLevel(level)
+
+	for name, lvl := range cfg.Subsystems {
+		level, err := logrus.ParseLevel(lvl)
+		if err != nil {
+			return fmt.Errorf("invalid log level for subsystem %q: %w", name, err)
+		}
+		subsystemLevels.set(name, level)
+	}
+
+	// slog records are filtered by subsystemHandler, so they go through a
+	// logger that shares the standard logger's output and hooks but doesn't
+	// filter on its own; otherwise a subsystem could never log below the
+	// global level.
+	std := logrus.StandardLogger()
+	slogLogger := logrus.New()
+	slogLogger.SetOutput(std.Out)
+	slogLogger.SetFormatter(std.Formatter)
+	slogLogger.ReplaceHooks(std.Hooks)
+	slogLogger.SetLevel(logrus.TraceLevel)
+
+	slog.SetDefault(slog.New(&subsystemHandler{
+		inner: sloglogrus.Option{
+			Level:     slog.LevelDebug,
+			Logger:    slogLogger,
+			AddSource: true,
+		}.NewLogrusHandler(),
+		levels: subsystemLevels,
+	}))
+
+	return nil
+}
+
+func logFormatter(format string) (logrus.Formatter, error) {
+	switch format {
+	case "", logFormatConsole:
+		return &logrus.TextFormatter{FullTimestamp: true}, nil
+	case logFormatLogfmt:
+		return &logrus.TextFormatter{FullTimestamp: true, DisableColors: true}, nil
+	case logFormatJSON:
+		return &logrus.JSONFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+}
+
+func syslogHook(addr string) (logrus.Hook, error) {
+	if addr == "local" {
+		return lsyslog.NewSyslogHook("", "", syslog.LOG_INFO, "dagger-engine")
+	}
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+	return lsyslog.NewSyslogHook(u.Scheme, u.Host, syslog.LOG_INFO, "dagger-engine")
+}
+
+func setLogLevel(level logrus.Level) {
+	logrus.SetLevel(level)
+	logLevel.Set(slogLevel(level))
+}
+
+func slogLevel(level logrus.Level) slog.Level {
+	switch {
+	case level >= logrus.DebugLevel:
+		return slog.LevelDebug
+	case level >= logrus.InfoLevel:
+		return slog.LevelInfo
+	case level >= logrus.WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// logLevels maps subsystems to the minimum level of records to log from
+// them. Subsystems without a level use logLevel.
+type logLevels struct {
+	mu     sync.RWMutex
+	levels map[string]logrus.Level
+}
+
+func (l *logLevels) set(subsystem string, level logrus.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levels[subsystem] = level
+}
+
+func (l *logLevels) enabled(subsystem string, level slog.Level) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if lowest, ok := l.levels[subsystem]; ok && subsystem != "" {
+		return level >= slogLevel(lowest)
+	}
+	return level >= logLevel.Level()
+}
+
+// minLevel returns the lowest level enabled for any subsystem.
+func (l *logLevels) minLevel() slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	lowest := logLevel.Level()
+	for _, level := range l.levels {
+		if level := slogLevel(level); level < lowest {
+			lowest = level
+		}
+	}
+	return lowest
+}
+
+func (l *logLevels) snapshot() map[string]string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	levels := map[string]string{}
+	for name, level := range l.levels {
+		levels[name] = level.String()
+	}
+	return levels
+}
+
+// subsystemLogAttr is the slog attribute that names the subsystem a record
+// comes from, e.g. slog.With("subsystem", "dagql").
+const subsystemLogAttr = "subsystem"
+
+// subsystemHandler drops slog records below the level configured for their
+// subsystem.
+type subsystemHandler struct {
+	inner     slog.Handler
+	levels    *logLevels
+	subsystem string
+}
+
+var _ slog.Handler = (*subsystemHandler)(nil)
+
+func (h *subsystemHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.subsystem != "" {
+		return h.levels.enabled(h.subsystem, level)
+	}
+	// the record may still set its own subsystem, so only rule out levels
+	// that no subsystem would log
+	return level >= h.levels.minLevel()
+}
+
+func (h *subsystemHandler) Handle(ctx context.Context, r slog.Record) error {
+	subsystem := h.subsystem
+	r.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == subsystemLogAttr {
+			subsystem = attr.Value.String()
+			return false
+		}
+		return true
+	})
+	if !h.levels.enabled(subsystem, r.Level) {
+		return nil
+	}
+	return h.inner.Handle(ctx, r)
+}
+
+func (h *subsystemHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	subsystem := h.subsystem
+	for _, attr := range attrs {
+		if attr.Key == subsystemLogAttr {
+			subsystem = attr.Value.String()
+		}
+	}
+	return &subsystemHandler{
+		inner:     h.inner.WithAttrs(attrs),
+		levels:    h.levels,
+		subsystem: subsystem,
+	}
+}
+
+func (h *subsystemHandler) WithGroup(name string) slog.Handler {
+	return &subsystemHandler{
+		inner:     h.inner.WithGroup(name),
+		levels:    h.levels,
+		subsystem: h.subsystem,
+	}
+}
+
+// handleLogLevel reports the current log levels on GET, and changes them on
+// PUT or POST with a "level" query parameter, e.g. ?level=debug. With a
+// "subsystem" parameter, only the level of that subsystem is changed, e.g.
+// ?subsystem=dagql&level=debug.
+func handleLogLevel(rw http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		query := req.URL.Query()
+		level, err := logrus.ParseLevel(query.Get("level"))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if subsystem := query.Get("subsystem"); subsystem != "" {
+			subsystemLevels.set(subsystem, level)
+			logrus.Infof("log level of subsystem %s set to %s from debug endpoint", subsystem, level)
+		} else {
+			setLogLevel(level)
+			logrus.Infof("log level set to %s from debug endpoint", level)
+		}
+	default:
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(rw).Encode(map[string]any{
+		"level":      logrus.GetLevel().String(),
+		"subsystems": subsystemLevels.snapshot(),
+	})
+	if err != nil {
+		logrus.WithError(err).Warn("failed to write log level response")
+	}
+}
+
+// rotatingFile is an io.Writer that writes to a file, renaming it to
+// <path>.1, <path>.2, ... once it grows beyond maxSize.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+var _ io.Writer = (*rotatingFile)(nil)
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	w := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *rotatingFile) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *rotatingFile) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.f = f
+	w.size = stat.Size()
+	return nil
+}
+
+func (w *rotatingFile) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	if w.maxBackups > 0 {
+		for i := w.maxBackups - 1; i > 0; i-- {
+			src := w.path + "." + strconv.Itoa(i)
+			dst := w.path + "." + strconv.Itoa(i+1)
+			if err := os.Rename(src, dst); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(w.path, w.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return err
+	}
+	return w.open()
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestLogFormatter(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{"", logFormatConsole, logFormatLogfmt} {
+		f, err := logFormatter(format)
+		require.NoError(t, err)
+		require.IsType(t, &logrus.TextFormatter{}, f)
+	}
+
+	f, err := logFormatter(logFormatJSON)
+	require.NoError(t, err)
+	require.IsType(t, &logrus.JSONFormatter{}, f)
+
+	_, err = logFormatter("xml")
+	require.Error(t, err)
+}
+
+func TestLoadLogConfig(t *testing.T) {
+	t.Parallel()
+
+	load := func(t *testing.T, config string, args ...string) logConfig {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "engine.toml")
+		if config != "" {
+			require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+		}
+		var cfg logConfig
+		app := cli.NewApp()
+		app.Flags = append([]cli.Flag{
+			cli.StringFlag{Name: "config", Value: path},
+		}, logFlags...)
+		app.Action = func(c *cli.Context) (err error) {
+			cfg, err = loadLogConfig(c)
+			return err
+		}
+		require.NoError(t, app.Run(append([]string{"engine"}, args...)))
+		return cfg
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		cfg := load(t, "")
+		require.Equal(t, logFormatConsole, *cfg.Format)
+		require.Equal(t, "", *cfg.File)
+		require.Equal(t, 100, *cfg.MaxSizeMB)
+		require.Equal(t, 3, *cfg.MaxBackups)
+		require.Nil(t, cfg.Level)
+	})
+
+	t.Run("config file", func(t *testing.T) {
+		cfg := load(t, `
+debug = true
+
+[log]
+format = "json"
+file = "/var/log/engine.log"
+maxSize = 0
+level = "warn"
+`)
+		require.Equal(t, logFormatJSON, *cfg.Format)
+		require.Equal(t, "/var/log/engine.log", *cfg.File)
+		require.Equal(t, 0, *cfg.MaxSizeMB)
+		require.Equal(t, 3, *cfg.MaxBackups)
+		require.Equal(t, "warn", *cfg.Level)
+	})
+
+	t.Run("flags override config file", func(t *testing.T) {
+		cfg := load(t, `
+[log]
+format = "json"
+level = "warn"
+
+[log.subsystems]
+dagql = "info"
+`,
+			"--log-format", logFormatLogfmt,
+			"--log-level", "debug",
+			"--log-subsystem-level", "dagql=trace",
+			"--log-subsystem-level", "telemetry=error",
+		)
+		require.Equal(t, logFormatLogfmt, *cfg.Format)
+		require.Equal(t, "debug", *cfg.Level)
+		require.Equal(t, map[string]string{
+			"dagql":     "trace",
+			"telemetry": "error",
+		}, cfg.Subsystems)
+	})
+}
+
+func TestLogConfigLevel(t *testing.T) {
+	t.Parallel()
+
+	warn := "warn"
+	for _, tc := range []struct {
+		cfg          logConfig
+		debug, trace bool
+		level        logrus.Level
+	}{
+		{cfg: logConfig{}, level: logrus.InfoLevel},
+		{cfg: logConfig{Level: &warn}, level: logrus.WarnLevel},
+		{cfg: logConfig{Level: &warn}, debug: true, level: logrus.DebugLevel},
+		{cfg: logConfig{}, debug: true, trace: true, level: logrus.TraceLevel},
+	} {
+		level, err := tc.cfg.level(tc.debug, tc.trace)
+		require.NoError(t, err)
+		require.Equal(t, tc.level, level)
+	}
+
+	bogus := "loud"
+	_, err := logConfig{Level: &bogus}.level(false, false)
+	require.Error(t, err)
+}
+
+func TestSubsystemHandler(t *testing.T) {
+	t.Parallel()
+
+	levels := &logLevels{levels: map[string]logrus.Level{
+		"chatty": logrus.ErrorLevel,
+		"quiet":  logrus.DebugLevel,
+	}}
+	rec := &recordingHandler{}
+	logger := slog.New(&subsystemHandler{inner: rec, levels: levels})
+
+	// no subsystem: global level (info)
+	logger.Debug("dropped")
+	logger.Info("kept")
+
+	// subsystem set on the record
+	logger.Debug("kept", subsystemLogAttr, "quiet")
+	logger.Warn("dropped", subsystemLogAttr, "chatty")
+
+	// subsystem set on the logger
+	logger.With(subsystemLogAttr, "quiet").Debug("kept")
+	logger.With(subsystemLogAttr, "chatty").Error("kept")
+	logger.With(subsystemLogAttr, "chatty").WithGroup("g").Info("dropped")
+
+	require.Equal(t, []string{"kept", "kept", "kept", "kept"}, rec.msgs)
+}
+
+type recordingHandler struct {
+	msgs []string
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.msgs = append(h.msgs, r.Message)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *recordingHandler) WithGroup(string) slog.Handler { return h }
+
+func TestRotatingFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "logs", "engine.log")
+	w, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		_, err := w.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "dddddddd\n", string(current))
+
+	backup1, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "cccccccc\n", string(backup1))
+
+	backup2, err := os.ReadFile(path + ".2")
+	require.NoError(t, err)
+	require.Equal(t, "bbbbbbbb\n", string(backup2))
+
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
@@ -6,7 +6,6 @@ import (
 	"crypto/x509"
 	goerrors "errors"
 	"fmt"
-	"log/slog"
 	"net"
 	"os"
 	"os/user"
@@ -64,7 +63,6 @@ import (
 	"github.com/moby/buildkit/worker"
 	ocispecs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
-	sloglogrus "github.com/samber/slog-logrus/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -222,6 +220,7 @@ func main() { //nolint:gocyclo
 			Value: network.DefaultCIDR,
 		},
 	)
+	app.Flags = append(app.Flags, logFlags...)
 	app.Flags = append(app.Flags, appFlags...)
 
 	app.Action = func(c *cli.Context) error {
@@ -261,21 +260,17 @@ func main() { //nolint:gocyclo
 			return err
 		}
 
-		logrus.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
-
-		// Wire slog up to send to Logrus so engine logs using slog also get sent
-		// to Cloud
-		slogOpts := sloglogrus.Option{
-			AddSource: true,
+		logCfg, err := loadLogConfig(c)
+		if err != nil {
+			return err
 		}
-		if cfg.Debug {
-			slogOpts.Level = slog.LevelDebug
-			logrus.SetLevel(logrus.DebugLevel)
+		level, err := logCfg.level(cfg.Debug, cfg.Trace)
+		if err != nil {
+			return err
 		}
-		if cfg.Trace {
-			logrus.SetLevel(logrus.TraceLevel)
+		if err := setupLogging(logCfg, level); err != nil {
+			return err
 		}
-		slog.SetDefault(slog.New(slogOpts.NewLogrusHandler()))
 
 		if cfg.GRPC.DebugAddress != "" {
 			if err := setupDebugHandlers(cfg.GRPC.DebugAddress); err != nil {
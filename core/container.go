@@ -1302,7 +1302,7 @@ func (container *Container) Publish(
 	platformVariants []*Container,
 	forcedCompression ImageLayerCompression,
 	mediaTypes ImageMediaTypes,
-) (string, error) {
+) (_ string, rerr error) {
 	if mediaTypes == "" {
 		// Modern registry implementations support oci types and docker daemons
 		// have been capable of pulling them since 2018:
@@ -1354,13 +1354,16 @@ func (container *Container) Publish(
 	svcs := container.Query.Services
 	bk := container.Query.Buildkit
 
+	ctx, vtx := progrock.Span(ctx, identity.NewID(), fmt.Sprintf("publish %s", ref))
+	defer func() { vtx.Done(rerr) }()
+
 	detach, _, err := svcs.StartBindings(ctx, services)
 	if err != nil {
 		return "", err
 	}
 	defer detach()
 
-	resp, err := bk.PublishContainerImage(ctx, inputByPlatform, opts)
+	resp, err := bk.PublishContainerImage(ctx, vtx, inputByPlatform, opts)
 	if err != nil {
 		return "", err
 	}
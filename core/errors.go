@@ -0,0 +1,49 @@
+package core
+
+import (
+	"github.com/dagger/dagger/dagql"
+	"github.com/dagger/dagger/dagql/call"
+	"github.com/dagger/dagger/engine"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ErrorCode is a GraphQL enum type of the codes that API errors carry in
+// their engine.ErrorCodeExtension.
+//
+// No field returns it; it is part of the schema so that SDKs generate their
+// error code constants from it instead of keeping their own copies.
+type ErrorCode string
+
+var ErrorCodes = dagql.NewEnum[ErrorCode]()
+
+var (
+	ErrorCodeExec = ErrorCodes.Register(ErrorCode(engine.ErrorCodeExec),
+		`A command executed in a container failed. The error also has "cmd", "exitCode", "stdout" and "stderr" extensions.`)
+	ErrorCodeModuleNotFound = ErrorCodes.Register(ErrorCode(engine.ErrorCodeModuleNotFound),
+		"A module, or one of its dependencies, does not exist at the given source.")
+	ErrorCodeClientClosed = ErrorCodes.Register(ErrorCode(engine.ErrorCodeClientClosed),
+		"The session of the client making the request was already closed.")
+	ErrorCodeTimeout = ErrorCodes.Register(ErrorCode(engine.ErrorCodeTimeout),
+		"The request exceeded its deadline.")
+	ErrorCodeCanceled = ErrorCodes.Register(ErrorCode(engine.ErrorCodeCanceled),
+		"The request was canceled before it completed.")
+)
+
+func (code ErrorCode) Type() *ast.Type {
+	return &ast.Type{
+		NamedType: "ErrorCode",
+		NonNull:   true,
+	}
+}
+
+func (code ErrorCode) TypeDescription() string {
+	return `A machine-readable code for a class of API errors, sent in the "_type" extension of GraphQL errors.`
+}
+
+func (code ErrorCode) Decoder() dagql.InputDecoder {
+	return ErrorCodes
+}
+
+func (code ErrorCode) ToLiteral() call.Literal {
+	return ErrorCodes.Literal(code)
+}
@@ -272,7 +272,7 @@ func (file *File) Export(ctx context.Context, dest string, allowParentDirPath bo
 	}
 	defer detach()
 
-	return bk.LocalFileExport(ctx, def.ToPB(), dest, file.File, allowParentDirPath)
+	return bk.LocalFileExport(ctx, vtx, def.ToPB(), dest, file.File, allowParentDirPath)
 }
 
 // bkRef returns the buildkit reference from the solved def.
@@ -1271,6 +1271,13 @@ func TestModuleDaggerGitRefs(t *testing.T) {
 		}).AsString(ctx)
 		require.NoError(t, err)
 	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		t.Parallel()
+		_, err := c.ModuleSource(gitTestRepoURL + "@this-ref-does-not-exist").AsString(ctx)
+		require.Error(t, err)
+		require.Equal(t, dagger.ErrorCodeModuleNotFound, dagger.ErrorCodeOf(err))
+	})
 }
 
 func TestModuleDaggerGitWithSources(t *testing.T) {
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/dagger/dagger/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryNow(t *testing.T) {
+	t.Parallel()
+
+	c, ctx := connect(t)
+
+	before := time.Now().Add(-time.Minute)
+
+	first, err := c.Now(ctx)
+	require.NoError(t, err)
+	firstTime, err := time.Parse(time.RFC3339Nano, first)
+	require.NoError(t, err)
+	require.True(t, firstTime.After(before))
+
+	// impure, so it must not be cached
+	second, err := c.Now(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
+
+func TestQueryUUID(t *testing.T) {
+	t.Parallel()
+
+	c, ctx := connect(t)
+
+	first, err := c.UUID(ctx)
+	require.NoError(t, err)
+	_, err = uuid.Parse(first)
+	require.NoError(t, err)
+
+	second, err := c.UUID(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
+
+func TestQueryRandomBytes(t *testing.T) {
+	t.Parallel()
+
+	c, ctx := connect(t)
+
+	t.Run("returns base64 of the requested size", func(t *testing.T) {
+		first, err := c.RandomBytes(ctx, 32)
+		require.NoError(t, err)
+		dt, err := base64.StdEncoding.DecodeString(first)
+		require.NoError(t, err)
+		require.Len(t, dt, 32)
+
+		second, err := c.RandomBytes(ctx, 32)
+		require.NoError(t, err)
+		require.NotEqual(t, first, second)
+	})
+
+	t.Run("rejects sizes out of range", func(t *testing.T) {
+		_, err := c.RandomBytes(ctx, -1)
+		require.Error(t, err)
+		_, err = c.RandomBytes(ctx, 64*1024+1)
+		require.Error(t, err)
+	})
+}
+
+func TestQueryImpureWithinQuery(t *testing.T) {
+	t.Parallel()
+
+	// the same impure field selected twice in a single query must be
+	// evaluated twice rather than deduplicated
+	var res struct {
+		Now1         string
+		Now2         string
+		UUID1        string
+		UUID2        string
+		RandomBytes1 string
+		RandomBytes2 string
+	}
+	err := testutil.Query(
+		`{
+			now1: now
+			now2: now
+			uuid1: uuid
+			uuid2: uuid
+			randomBytes1: randomBytes(size: 16)
+			randomBytes2: randomBytes(size: 16)
+		}`, &res, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, res.Now1, res.Now2)
+	require.NotEqual(t, res.UUID1, res.UUID2)
+	require.NotEqual(t, res.RandomBytes1, res.RandomBytes2)
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/dagger/dagger/core"
 	"github.com/dagger/dagger/dagql"
+	"github.com/dagger/dagger/engine"
 )
 
 var _ SchemaResolvers = &gitSchema{}
@@ -230,6 +232,40 @@ func gitTags(ctx context.Context, repoURL string) ([]string, error) {
 	return tags, nil
 }
 
+// gitNotFoundErrors match the errors git and Buildkit's git source return
+// when a repository or ref doesn't exist.
+var gitNotFoundErrors = []*regexp.Regexp{
+	regexp.MustCompile(`repository does not contain ref`),
+	regexp.MustCompile(`(?i)repository not found`),
+	regexp.MustCompile(`repository '[^']*' not found`),
+	regexp.MustCompile(`couldn't find remote ref`),
+	regexp.MustCompile(`unknown revision`),
+	regexp.MustCompile(`reference is not a tree`),
+}
+
+// gitResolveError annotates err with engine.ErrorCodeModuleNotFound if it
+// means the repository or ref doesn't exist; other errors are left as-is.
+func gitResolveError(err error) error {
+	if isGitNotFound(err) {
+		return engine.NewCodedError(engine.ErrorCodeModuleNotFound, err)
+	}
+	return err
+}
+
+// isGitNotFound returns whether err means that a git repository or ref
+// doesn't exist, as opposed to e.g. a network, auth or timeout failure.
+func isGitNotFound(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	for _, re := range gitNotFoundErrors {
+		if re.MatchString(err.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
 func isSemver(ver string) bool {
 	re := regexp.MustCompile(`^v[0-9]+\.[0-9]+\.[0-9]+$`)
 	return re.MatchString(ver)
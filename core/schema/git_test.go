@@ -1,6 +1,9 @@
 package schema
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -33,3 +36,23 @@ func TestIsSemver(t *testing.T) {
 	require.False(t, isSemver("v1"))
 	require.False(t, isSemver("foo"))
 }
+
+func TestIsGitNotFound(t *testing.T) {
+	for _, msg := range []string{
+		`failed to resolve git src: repository does not contain ref nope, output: ""`,
+		"remote: Repository not found.\nfatal: repository 'https://github.com/foo/bar/' not found",
+		"fatal: repository 'https://gitlab.com/foo/bar.git/' not found",
+		"fatal: couldn't find remote ref refs/heads/nope",
+		"fatal: unknown revision or path not in the working tree",
+	} {
+		require.True(t, isGitNotFound(errors.New(msg)), msg)
+	}
+
+	for _, err := range []error{
+		errors.New("fatal: unable to access 'https://github.com/foo/bar/': Could not resolve host: github.com"),
+		errors.New("fatal: could not read Username for 'https://github.com': terminal prompts disabled"),
+		fmt.Errorf("repository does not contain ref nope: %w", context.DeadlineExceeded),
+	} {
+		require.False(t, isGitNotFound(err), err.Error())
+	}
+}
@@ -722,7 +722,7 @@ func (s *moduleSchema) updateDeps(
 		if !cfgExists {
 			// best effort for err message, ignore err
 			sourceRootPath, _ := dep.Self.Source.Self.SourceRootSubpath()
-			return fmt.Errorf("module %q dependency %q with source root path %q does not exist or does not have a configuration file", mod.NameField, dep.Self.Name, sourceRootPath)
+			return engine.Errorf(engine.ErrorCodeModuleNotFound, "module %q dependency %q with source root path %q does not exist or does not have a configuration file", mod.NameField, dep.Self.Name, sourceRootPath)
 		}
 		mod.DependencyConfig[i] = dep.Self
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/dagger/dagger/core"
 	"github.com/dagger/dagger/core/modules"
 	"github.com/dagger/dagger/dagql"
+	"github.com/dagger/dagger/engine"
 	"github.com/dagger/dagger/engine/buildkit"
 	"github.com/vito/progrock"
 	"golang.org/x/sync/errgroup"
@@ -111,11 +112,11 @@ func (s *moduleSchema) moduleSource(ctx context.Context, query *core.Query, args
 			},
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to resolve git src: %w", err)
+			return nil, gitResolveError(fmt.Errorf("failed to resolve git src: %w", err))
 		}
 		gitCommit, err := gitRef.Self.Commit(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to resolve git src to commit: %w", err)
+			return nil, gitResolveError(fmt.Errorf("failed to resolve git src to commit: %w", err))
 		}
 		src.AsGitSource.Value.Commit = gitCommit
 
@@ -680,7 +681,7 @@ func (s *moduleSchema) moduleSourceResolveFromCaller(
 			}
 		} else {
 			if localDep.modCfg == nil {
-				return inst, fmt.Errorf("local module source dep %s is not initialized", rootPath)
+				return inst, engine.Errorf(engine.ErrorCodeModuleNotFound, "local module source dep %s is not initialized", rootPath)
 			}
 			if localDep.sdk == nil {
 				return inst, fmt.Errorf("local module source dep %s has no sdk", rootPath)
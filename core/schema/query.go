@@ -2,12 +2,16 @@ package schema
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/dagger/dagger/dagql"
 	"github.com/dagger/dagger/dagql/introspection"
+	"github.com/google/uuid"
 	"github.com/vito/progrock"
 
 	"github.com/dagger/dagger/core"
@@ -33,6 +37,7 @@ func (s *querySchema) Install() {
 	core.CacheSharingModes.Install(s.srv)
 	core.TypeDefKinds.Install(s.srv)
 	core.ModuleSourceKindEnum.Install(s.srv)
+	core.ErrorCodes.Install(s.srv)
 
 	dagql.MustInputSpec(pipeline.Label{}).Install(s.srv)
 	dagql.MustInputSpec(core.PortForward{}).Install(s.srv)
@@ -54,6 +59,19 @@ func (s *querySchema) Install() {
 		dagql.Func("checkVersionCompatibility", s.checkVersionCompatibility).
 			Doc(`Checks if the current Dagger Engine is compatible with an SDK's required version.`).
 			ArgDoc("version", "Version required by the SDK."),
+
+		dagql.Func("now", s.now).
+			Impure("Returns the time at which it is called.").
+			Doc(`Returns the current time on the engine, formatted as an RFC 3339 timestamp.`),
+
+		dagql.Func("uuid", s.uuid).
+			Impure("Returns a new UUID every time it is called.").
+			Doc(`Returns a new random (version 4) UUID.`),
+
+		dagql.Func("randomBytes", s.randomBytes).
+			Impure("Returns new random bytes every time it is called.").
+			Doc(`Returns cryptographically secure random bytes, encoded as standard base64.`).
+			ArgDoc("size", "Number of random bytes to generate, up to 65536."),
 	}.Install(s.srv)
 }
 
@@ -71,6 +89,35 @@ func (s *querySchema) pipeline(ctx context.Context, parent *core.Query, args pip
 	), nil
 }
 
+func (s *querySchema) now(ctx context.Context, _ *core.Query, _ struct{}) (dagql.String, error) {
+	return dagql.NewString(time.Now().UTC().Format(time.RFC3339Nano)), nil
+}
+
+func (s *querySchema) uuid(ctx context.Context, _ *core.Query, _ struct{}) (dagql.String, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate uuid: %w", err)
+	}
+	return dagql.NewString(id.String()), nil
+}
+
+const maxRandomBytes = 64 * 1024
+
+type randomBytesArgs struct {
+	Size int
+}
+
+func (s *querySchema) randomBytes(ctx context.Context, _ *core.Query, args randomBytesArgs) (dagql.String, error) {
+	if args.Size < 0 || args.Size > maxRandomBytes {
+		return "", fmt.Errorf("size must be between 0 and %d, got %d", maxRandomBytes, args.Size)
+	}
+	buf := make([]byte, args.Size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return dagql.NewString(base64.StdEncoding.EncodeToString(buf)), nil
+}
+
 type checkVersionCompatibilityArgs struct {
 	Version string
 }
@@ -44,6 +44,8 @@ const (
 	entitlementsJobKey = "llb.entitlements"
 )
 
+var errClientClosed = engine.NewCodedError(engine.ErrorCodeClientClosed, errors.New("client closed"))
+
 // Opts for a Client that are shared across all instances for a given DaggerServer
 type Opts struct {
 	Worker                bkworker.Worker
@@ -231,7 +233,7 @@ func (c *Client) withClientCloseCancel(ctx context.Context) (context.Context, co
 	defer c.closeMu.RUnlock()
 	select {
 	case <-c.closeCtx.Done():
-		return nil, nil, errors.New("client closed")
+		return nil, nil, errClientClosed
 	default:
 	}
 	ctx, cancel := context.WithCancel(ctx)
@@ -461,7 +463,7 @@ func (c *Client) NewContainer(ctx context.Context, req bkgw.NewContainerRequest)
 		if err := ctr.Release(context.Background()); err != nil {
 			return nil, fmt.Errorf("release after close: %w", err)
 		}
-		return nil, errors.New("client closed")
+		return nil, errClientClosed
 	}
 	c.containers[ctr] = struct{}{}
 	return ctr, nil
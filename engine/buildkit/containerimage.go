@@ -27,6 +27,7 @@ type ContainerExport struct {
 
 func (c *Client) PublishContainerImage(
 	ctx context.Context,
+	vtx *progrock.VertexRecorder,
 	inputByPlatform map[string]ContainerExport,
 	opts map[string]string, // TODO: make this an actual type, this leaks too much untyped buildkit api
 ) (map[string]string, error) {
@@ -51,6 +52,9 @@ func (c *Client) PublishContainerImage(
 		return nil, fmt.Errorf("failed to resolve exporter: %s", err)
 	}
 
+	ctx, doneProgress := withProgress(ctx, vtx)
+	defer doneProgress()
+
 	resp, descRef, err := expInstance.Export(ctx, combinedResult, nil, c.ID())
 	if err != nil {
 		return nil, fmt.Errorf("failed to export: %s", err)
@@ -1,5 +1,7 @@
 package buildkit
 
+import "github.com/dagger/dagger/engine"
+
 // ExecError is an error that occurred while executing an `Op_Exec`.
 type ExecError struct {
 	original error
@@ -19,10 +21,10 @@ func (e *ExecError) Unwrap() error {
 
 func (e *ExecError) Extensions() map[string]interface{} {
 	return map[string]interface{}{
-		"_type":    "EXEC_ERROR",
-		"cmd":      e.Cmd,
-		"exitCode": e.ExitCode,
-		"stdout":   e.Stdout,
-		"stderr":   e.Stderr,
+		engine.ErrorCodeExtension: string(engine.ErrorCodeExec),
+		"cmd":                     e.Cmd,
+		"exitCode":                e.ExitCode,
+		"stdout":                  e.Stdout,
+		"stderr":                  e.Stderr,
 	}
 }
@@ -177,6 +177,7 @@ func (c *Client) StatCallerHostPath(ctx context.Context, path string, returnAbsP
 
 func (c *Client) LocalDirExport(
 	ctx context.Context,
+	vtx *progrock.VertexRecorder,
 	def *bksolverpb.Definition,
 	destPath string,
 	merge bool,
@@ -231,6 +232,9 @@ func (c *Client) LocalDirExport(
 		Merge: merge,
 	}.AppendToOutgoingContext(ctx)
 
+	ctx, doneProgress := withProgress(ctx, vtx)
+	defer doneProgress()
+
 	_, descRef, err := expInstance.Export(ctx, cacheRes, nil, clientMetadata.ClientID)
 	if err != nil {
 		return fmt.Errorf("failed to export: %s", err)
@@ -243,6 +247,7 @@ func (c *Client) LocalDirExport(
 
 func (c *Client) LocalFileExport(
 	ctx context.Context,
+	vtx *progrock.VertexRecorder,
 	def *bksolverpb.Definition,
 	destPath string,
 	filePath string,
@@ -329,6 +334,14 @@ func (c *Client) LocalFileExport(
 	}
 	defer diffCopyClient.CloseSend()
 
+	ctx, doneProgress := withProgress(ctx, vtx)
+	defer doneProgress()
+
+	prog := newByteProgress(ctx, "transferring "+filepath.Base(filePath), stat.Size())
+	defer func() {
+		prog.Done(rerr)
+	}()
+
 	fileSizeLeft := stat.Size()
 	chunkSize := int64(MaxFileContentsChunkSize)
 	for fileSizeLeft > 0 {
@@ -350,6 +363,7 @@ func (c *Client) LocalFileExport(
 		} else if err != nil {
 			return fmt.Errorf("failed to send file chunk: %s", err)
 		}
+		prog.Add(n)
 	}
 	if err := diffCopyClient.CloseSend(); err != nil {
 		return fmt.Errorf("failed to close send: %s", err)
@@ -0,0 +1,138 @@
+package buildkit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/moby/buildkit/util/bklog"
+	"github.com/moby/buildkit/util/progress"
+	"github.com/vito/progrock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// withProgress installs a Buildkit progress writer in the returned context
+// and forwards every progress.Status written to it (files transferred, layers
+// pushed, etc.) to the given vertex as a Progrock task.
+//
+// Exporters are called outside of a Buildkit job, so without this their
+// progress is silently dropped and long exports show up as a single vertex
+// with no indication of how far along they are.
+//
+// The returned func must be called once the export is done; it blocks until
+// all progress has been forwarded.
+func withProgress(ctx context.Context, vtx *progrock.VertexRecorder) (context.Context, func()) {
+	if vtx == nil {
+		return ctx, func() {}
+	}
+
+	pr, ctx, closeWriter := progress.NewContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			// NB: read with a background context so we don't miss the final
+			// completed statuses if the export's context is canceled
+			ps, err := pr.Read(context.Background())
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					bklog.G(ctx).WithError(err).Warn("failed to read export progress")
+				}
+				return
+			}
+			if len(ps) == 0 {
+				continue
+			}
+			status := &progrock.StatusUpdate{}
+			for _, p := range ps {
+				st, ok := p.Sys.(progress.Status)
+				if !ok {
+					continue
+				}
+				status.Tasks = append(status.Tasks, progressTask(vtx, p.ID, st))
+			}
+			if len(status.Tasks) == 0 {
+				continue
+			}
+			if err := vtx.Recorder.Record(status); err != nil {
+				bklog.G(ctx).WithError(err).Warn("failed to record export progress")
+			}
+		}
+	}()
+
+	return ctx, func() {
+		closeWriter()
+		<-done
+	}
+}
+
+func progressTask(vtx *progrock.VertexRecorder, id string, st progress.Status) *progrock.VertexTask {
+	task := &progrock.VertexTask{
+		Vertex:  vtx.Vertex.Id,
+		Name:    id,
+		Total:   int64(st.Total),
+		Current: int64(st.Current),
+	}
+	if st.Started != nil {
+		task.Started = timestamppb.New(*st.Started)
+	}
+	if st.Completed != nil {
+		task.Completed = timestamppb.New(*st.Completed)
+	}
+	return task
+}
+
+// byteProgressInterval is the minimum time between two progress updates for
+// the same item, matching what Buildkit's local exporter uses.
+const byteProgressInterval = 100 * time.Millisecond
+
+// byteProgress reports bytes transferred for a single item through the
+// Buildkit progress writer in ctx, if any. Updates are throttled to
+// byteProgressInterval; the final update is always written by Done.
+type byteProgress struct {
+	pw progress.Writer
+	id string
+	st progress.Status
+
+	lastWrite time.Time
+}
+
+func newByteProgress(ctx context.Context, id string, total int64) *byteProgress {
+	pw, _, _ := progress.NewFromContext(ctx)
+	now := time.Now()
+	p := &byteProgress{
+		pw: pw,
+		id: id,
+		st: progress.Status{
+			Action:  "transferring",
+			Total:   int(total),
+			Started: &now,
+		},
+	}
+	p.pw.Write(p.id, p.st)
+	p.lastWrite = now
+	return p
+}
+
+func (p *byteProgress) Add(n int64) {
+	p.st.Current += int(n)
+	now := time.Now()
+	if now.Sub(p.lastWrite) < byteProgressInterval {
+		return
+	}
+	p.pw.Write(p.id, p.st)
+	p.lastWrite = now
+}
+
+// Done writes the final update. If the transfer failed, the item is left
+// without a completion time so that it isn't reported as finished.
+func (p *byteProgress) Done(err error) {
+	if err == nil {
+		now := time.Now()
+		p.st.Completed = &now
+	}
+	p.pw.Write(p.id, p.st)
+	p.pw.Close()
+}
@@ -0,0 +1,132 @@
+package buildkit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/util/progress"
+	"github.com/stretchr/testify/require"
+	"github.com/vito/progrock"
+)
+
+func TestProgressTask(t *testing.T) {
+	t.Parallel()
+
+	vtx := &progrock.VertexRecorder{Vertex: &progrock.Vertex{Id: "vtx"}}
+
+	started := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	completed := started.Add(time.Minute)
+
+	task := progressTask(vtx, "pushing layer", progress.Status{
+		Action:    "pushing",
+		Current:   5,
+		Total:     10,
+		Started:   &started,
+		Completed: &completed,
+	})
+	require.Equal(t, "vtx", task.Vertex)
+	require.Equal(t, "pushing layer", task.Name)
+	require.Equal(t, int64(5), task.Current)
+	require.Equal(t, int64(10), task.Total)
+	require.Equal(t, started, task.Started.AsTime())
+	require.Equal(t, completed, task.Completed.AsTime())
+
+	task = progressTask(vtx, "pushing layer", progress.Status{})
+	require.Nil(t, task.Started)
+	require.Nil(t, task.Completed)
+}
+
+func TestByteProgress(t *testing.T) {
+	t.Parallel()
+
+	pr, ctx, closeWriter := progress.NewContext(context.Background())
+
+	statuses := make(chan progress.Status, 100)
+	go func() {
+		defer close(statuses)
+		for {
+			ps, err := pr.Read(context.Background())
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Error(err)
+				}
+				return
+			}
+			for _, p := range ps {
+				statuses <- p.Sys.(progress.Status)
+			}
+		}
+	}()
+
+	prog := newByteProgress(ctx, "transferring foo", 30)
+	prog.Add(10)
+	prog.Add(10)
+	prog.Add(10)
+	prog.Done(nil)
+	closeWriter()
+
+	var last progress.Status
+	var n int
+	for st := range statuses {
+		require.NotNil(t, st.Started)
+		require.LessOrEqual(t, st.Current, 30)
+		last = st
+		n++
+	}
+	require.NotZero(t, n)
+	require.Equal(t, 30, last.Total)
+	require.Equal(t, 30, last.Current)
+	require.NotNil(t, last.Completed)
+}
+
+func TestByteProgressThrottles(t *testing.T) {
+	t.Parallel()
+
+	prog := &byteProgress{
+		pw:        &countingWriter{},
+		lastWrite: time.Now(),
+	}
+	for i := 0; i < 100; i++ {
+		prog.Add(1)
+	}
+	require.Equal(t, 100, prog.st.Current)
+	require.Zero(t, prog.pw.(*countingWriter).writes)
+
+	prog.lastWrite = time.Now().Add(-byteProgressInterval)
+	prog.Add(1)
+	require.Equal(t, 1, prog.pw.(*countingWriter).writes)
+
+	prog.Done(nil)
+	require.Equal(t, 2, prog.pw.(*countingWriter).writes)
+	require.NotNil(t, prog.st.Completed)
+}
+
+func TestByteProgressFailed(t *testing.T) {
+	t.Parallel()
+
+	prog := &byteProgress{
+		pw: &countingWriter{},
+		st: progress.Status{Total: 10},
+	}
+	prog.Add(5)
+	prog.Done(errors.New("failed to send file chunk"))
+	require.Equal(t, 2, prog.pw.(*countingWriter).writes)
+	require.Equal(t, 5, prog.st.Current)
+	require.Nil(t, prog.st.Completed)
+}
+
+type countingWriter struct {
+	writes int
+}
+
+func (w *countingWriter) Write(string, any) error {
+	w.writes++
+	return nil
+}
+
+func (w *countingWriter) Close() error {
+	return nil
+}
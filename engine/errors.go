@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is a stable, machine-readable identifier for a class of API
+// errors. It is sent to clients in the ErrorCodeExtension of GraphQL errors,
+// which SDKs use to return typed errors that can be handled without matching
+// on error messages.
+//
+// Codes are part of the API: never change the value of an existing code. Each
+// code must also be registered in the ErrorCode enum of the schema (see
+// core.ErrorCodes), which documents it and from which the SDKs generate their
+// own constants.
+type ErrorCode string
+
+// ErrorCodeExtension is the GraphQL error extension that holds the ErrorCode.
+const ErrorCodeExtension = "_type"
+
+const (
+	ErrorCodeExec           ErrorCode = "EXEC_ERROR"
+	ErrorCodeModuleNotFound ErrorCode = "MODULE_NOT_FOUND"
+	ErrorCodeClientClosed   ErrorCode = "CLIENT_CLOSED"
+	ErrorCodeTimeout        ErrorCode = "TIMEOUT"
+	ErrorCodeCanceled       ErrorCode = "CANCELED"
+)
+
+// CodedError annotates an error with an ErrorCode.
+type CodedError struct {
+	Code ErrorCode
+	Err  error
+}
+
+// NewCodedError annotates err with the given code.
+func NewCodedError(code ErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{Code: code, Err: err}
+}
+
+// Errorf is like fmt.Errorf, but annotates the resulting error with the given
+// code.
+func Errorf(code ErrorCode, format string, args ...any) error {
+	return NewCodedError(code, fmt.Errorf(format, args...))
+}
+
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CodedError) Extensions() map[string]any {
+	return map[string]any{
+		ErrorCodeExtension: string(e.Code),
+	}
+}
+
+// ErrorCodeOf returns the ErrorCode of err, if any.
+//
+// Errors caused by a deadline or cancellation are always classified as
+// ErrorCodeTimeout or ErrorCodeCanceled, even if they were annotated with
+// another code along the way, since that is the failure the caller needs to
+// handle. Otherwise the code of the outermost CodedError is returned.
+func ErrorCodeOf(err error) (ErrorCode, bool) {
+	var coded *CodedError
+	switch {
+	case err == nil:
+		return "", false
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeTimeout, true
+	case errors.Is(err, context.Canceled):
+		return ErrorCodeCanceled, true
+	case errors.As(err, &coded):
+		return coded.Code, true
+	default:
+		return "", false
+	}
+}
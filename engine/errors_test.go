@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code ErrorCode
+		ok   bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+		},
+		{
+			name: "uncoded",
+			err:  errors.New("oops"),
+		},
+		{
+			name: "coded",
+			err:  Errorf(ErrorCodeModuleNotFound, "module %q not found", "foo"),
+			code: ErrorCodeModuleNotFound,
+			ok:   true,
+		},
+		{
+			name: "wrapped coded",
+			err:  fmt.Errorf("load: %w", NewCodedError(ErrorCodeClientClosed, errors.New("client closed"))),
+			code: ErrorCodeClientClosed,
+			ok:   true,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("solve: %w", context.DeadlineExceeded),
+			code: ErrorCodeTimeout,
+			ok:   true,
+		},
+		{
+			name: "canceled",
+			err:  fmt.Errorf("solve: %w", context.Canceled),
+			code: ErrorCodeCanceled,
+			ok:   true,
+		},
+		{
+			name: "coded deadline exceeded",
+			err:  Errorf(ErrorCodeModuleNotFound, "resolve git ref: %w", context.DeadlineExceeded),
+			code: ErrorCodeTimeout,
+			ok:   true,
+		},
+		{
+			name: "coded canceled",
+			err:  fmt.Errorf("load: %w", NewCodedError(ErrorCodeClientClosed, context.Canceled)),
+			code: ErrorCodeCanceled,
+			ok:   true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			code, ok := ErrorCodeOf(tc.err)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestCodedError(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, NewCodedError(ErrorCodeTimeout, nil))
+
+	base := errors.New("oops")
+	err := NewCodedError(ErrorCodeTimeout, base)
+	require.Equal(t, "oops", err.Error())
+	require.ErrorIs(t, err, base)
+
+	var coded *CodedError
+	require.ErrorAs(t, err, &coded)
+	require.Equal(t, map[string]any{"_type": "TIMEOUT"}, coded.Extensions())
+}
@@ -320,6 +320,13 @@ func (s *DaggerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	srv := handler.NewDefaultServer(schema)
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		res := next(ctx)
+		if res != nil {
+			setErrorCodes(res.Errors)
+		}
+		return res
+	})
 	// NB: break glass when needed:
 	// srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
 	// 	res := next(ctx)
@@ -367,6 +374,25 @@ func (s *DaggerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	handler.ServeHTTP(w, r)
 }
 
+// setErrorCodes sets the engine.ErrorCodeExtension on any errors that don't
+// already have one, so clients can rely on it for well-known failure classes
+// like timeouts regardless of where the error originated.
+func setErrorCodes(errs gqlerror.List) {
+	for _, gqlErr := range errs {
+		if _, ok := gqlErr.Extensions[engine.ErrorCodeExtension]; ok {
+			continue
+		}
+		code, ok := engine.ErrorCodeOf(gqlErr.Err)
+		if !ok {
+			continue
+		}
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]any{}
+		}
+		gqlErr.Extensions[engine.ErrorCodeExtension] = string(code)
+	}
+}
+
 func (s *DaggerServer) RegisterClient(clientID, clientHostname, secretToken string) error {
 	s.clientIDMu.Lock()
 	defer s.clientIDMu.Unlock()
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestSetErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	existing := &gqlerror.Error{
+		Message:    "exec failed",
+		Err:        fmt.Errorf("exec: %w", context.DeadlineExceeded),
+		Extensions: map[string]any{"_type": "EXEC_ERROR", "exitCode": 1},
+	}
+	timeout := &gqlerror.Error{
+		Message: "solve failed",
+		Err:     fmt.Errorf("solve: %w", context.DeadlineExceeded),
+	}
+	uncoded := &gqlerror.Error{
+		Message: "oops",
+		Err:     errors.New("oops"),
+	}
+	noErr := &gqlerror.Error{
+		Message: "syntax error",
+	}
+
+	setErrorCodes(gqlerror.List{existing, timeout, uncoded, noErr})
+
+	require.Equal(t, map[string]any{"_type": "EXEC_ERROR", "exitCode": 1}, existing.Extensions)
+	require.Equal(t, map[string]any{"_type": "TIMEOUT"}, timeout.Extensions)
+	require.Nil(t, uncoded.Extensions)
+	require.Nil(t, noErr.Extensions)
+}
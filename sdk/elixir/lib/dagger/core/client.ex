@@ -70,7 +70,7 @@ defmodule Dagger.Core.Client do
 
     case query(client, q) do
       {:ok, %{"data" => nil, "errors" => errors}} ->
-        {:error, %Dagger.QueryError{errors: errors}}
+        {:error, Dagger.QueryError.new(errors)}
 
       {:ok, %{"data" => data}} ->
         {:ok, select(data, Selection.path(selection))}
@@ -105,7 +105,30 @@ end
 defmodule Dagger.QueryError do
   @moduledoc false
 
-  defstruct [:errors]
+  # `code` is the `Dagger.ErrorCode` of the first error, such as
+  # `:EXEC_ERROR` or `:MODULE_NOT_FOUND`. Codes that aren't known to this
+  # version of the SDK are kept as strings, and it's `nil` if the engine
+  # sent none.
+  defstruct [:errors, :code]
+
+  @type t() :: %__MODULE__{errors: [map()], code: Dagger.ErrorCode.t() | String.t() | nil}
+
+  def new(errors) do
+    %__MODULE__{errors: errors, code: code(errors)}
+  end
+
+  defp code([%{"extensions" => %{"_type" => code}} | _]) when is_binary(code) do
+    # Look the code up in the generated enum instead of creating an atom for
+    # arbitrary input.
+    name = String.downcase(code)
+
+    Enum.find_value(Dagger.ErrorCode.__info__(:functions), code, fn
+      {fun, 0} -> if Atom.to_string(fun) == name, do: apply(Dagger.ErrorCode, fun, [])
+      _ -> nil
+    end)
+  end
+
+  defp code(_), do: nil
 end
 
 defmodule Dagger.Core.QueryBuilder do
@@ -673,6 +673,15 @@ defmodule Dagger.Client do
     }
   end
 
+  @doc "Returns the current time on the engine, formatted as an RFC 3339 timestamp."
+  @spec now(t()) :: {:ok, String.t()} | {:error, term()}
+  def now(%__MODULE__{} = client) do
+    selection =
+      client.selection |> select("now")
+
+    execute(selection, client.client)
+  end
+
   @doc "Creates a named sub-pipeline."
   @spec pipeline(t(), String.t(), [
           {:description, String.t() | nil},
@@ -692,6 +701,15 @@ defmodule Dagger.Client do
     }
   end
 
+  @doc "Returns cryptographically secure random bytes, encoded as standard base64."
+  @spec random_bytes(t(), integer()) :: {:ok, String.t()} | {:error, term()}
+  def random_bytes(%__MODULE__{} = client, size) do
+    selection =
+      client.selection |> select("randomBytes") |> put_arg("size", size)
+
+    execute(selection, client.client)
+  end
+
   @doc "Reference a secret by name."
   @spec secret(t(), String.t(), [{:accessor, String.t() | nil}]) :: Dagger.Secret.t()
   def secret(%__MODULE__{} = client, name, optional_args \\ []) do
@@ -750,4 +768,13 @@ defmodule Dagger.Client do
       client: client.client
     }
   end
+
+  @doc "Returns a new random (version 4) UUID."
+  @spec uuid(t()) :: {:ok, String.t()} | {:error, term()}
+  def uuid(%__MODULE__{} = client) do
+    selection =
+      client.selection |> select("uuid")
+
+    execute(selection, client.client)
+  end
 end
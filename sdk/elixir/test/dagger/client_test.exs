@@ -225,7 +225,7 @@ defmodule Dagger.ClientTest do
       |> Client.container()
       |> Container.from("alpine:3.16.2")
 
-    assert {:error, %QueryError{}} =
+    assert {:error, %QueryError{code: :EXEC_ERROR}} =
              container |> Container.with_exec(["foobar"]) |> Sync.sync()
 
     assert {:ok, %Container{} = container} =
@@ -309,4 +309,17 @@ defmodule Dagger.ClientTest do
              )
              |> Sync.sync()
   end
+
+  test "query error code" do
+    for {type, code} <- [
+          {"MODULE_NOT_FOUND", Dagger.ErrorCode.module_not_found()},
+          {"TIMEOUT", Dagger.ErrorCode.timeout()},
+          {"SOMETHING_NEW", "SOMETHING_NEW"}
+        ] do
+      errors = [%{"message" => "oops", "extensions" => %{"_type" => type}}]
+      assert %QueryError{errors: ^errors, code: ^code} = QueryError.new(errors)
+    end
+
+    assert %QueryError{code: nil} = QueryError.new([%{"message" => "oops"}])
+  end
 end
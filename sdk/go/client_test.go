@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestDirectory(t *testing.T) {
@@ -281,3 +282,30 @@ func TestExecError(t *testing.T) {
 		}
 	})
 }
+
+func TestQueryErrorCode(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		typ  string
+		code ErrorCode
+	}{
+		{"MODULE_NOT_FOUND", ErrorCodeModuleNotFound},
+		{"TIMEOUT", ErrorCodeTimeout},
+		{"SOMETHING_NEW", ErrorCode("SOMETHING_NEW")},
+	} {
+		err := getCustomError(&gqlerror.Error{
+			Message:    "oops",
+			Extensions: map[string]any{"_type": tc.typ},
+		})
+
+		var queryErr *QueryError
+		require.ErrorAs(t, err, &queryErr)
+		require.Equal(t, tc.code, queryErr.Code)
+		require.Equal(t, tc.code, ErrorCodeOf(err))
+		require.Contains(t, err.Error(), "oops")
+	}
+
+	require.Nil(t, getCustomError(&gqlerror.Error{Message: "oops"}))
+	require.Equal(t, ErrorCode(""), ErrorCodeOf(errors.New("oops")))
+}
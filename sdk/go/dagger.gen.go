@@ -67,7 +67,65 @@ func getCustomError(err error) error {
 		return e
 	}
 
-	return nil
+	return &QueryError{
+		original: err,
+		Code:     ErrorCode(typ),
+	}
+}
+
+// ErrorCode is a stable, machine-readable identifier for a class of API errors.
+//
+// The codes are generated from the ErrorCode enum of the API. Codes that
+// aren't known to this version of the SDK are passed through as-is.
+type ErrorCode string
+
+func (ErrorCode) IsEnum() {}
+
+const (
+	// The request was canceled before it completed.
+	ErrorCodeCanceled ErrorCode = "CANCELED"
+
+	// The session of the client making the request was already closed.
+	ErrorCodeClientClosed ErrorCode = "CLIENT_CLOSED"
+
+	// A command executed in a container failed. The error also has "cmd", "exitCode", "stdout" and "stderr" extensions.
+	ErrorCodeExecError ErrorCode = "EXEC_ERROR"
+
+	// A module, or one of its dependencies, does not exist at the given source.
+	ErrorCodeModuleNotFound ErrorCode = "MODULE_NOT_FOUND"
+
+	// The request exceeded its deadline.
+	ErrorCodeTimeout ErrorCode = "TIMEOUT"
+)
+
+// ErrorCodeOf returns the ErrorCode of an API error, or an empty string if
+// the error has none.
+func ErrorCodeOf(err error) ErrorCode {
+	var execErr *ExecError
+	if errors.As(err, &execErr) {
+		return ErrorCodeExecError
+	}
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		return queryErr.Code
+	}
+	return ""
+}
+
+// QueryError is an API error with a machine-readable error code.
+//
+// Codes that aren't known to this version of the SDK are passed through as-is.
+type QueryError struct {
+	original error
+	Code     ErrorCode
+}
+
+func (e *QueryError) Error() string {
+	return e.original.Error()
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.original
 }
 
 // ExecError is an API error from an exec operation.
@@ -5909,6 +5967,16 @@ func (r *Client) ModuleSource(refString string, opts ...ModuleSourceOpts) *Modul
 	}
 }
 
+// Returns the current time on the engine, formatted as an RFC 3339 timestamp.
+func (r *Client) Now(ctx context.Context) (string, error) {
+	q := r.query.Select("now")
+
+	var response string
+
+	q = q.Bind(&response)
+	return response, q.Execute(ctx)
+}
+
 // PipelineOpts contains options for Client.Pipeline
 type PipelineOpts struct {
 	// Description of the sub-pipeline.
@@ -5938,6 +6006,17 @@ func (r *Client) Pipeline(name string, opts ...PipelineOpts) *Client {
 	}
 }
 
+// Returns cryptographically secure random bytes, encoded as standard base64.
+func (r *Client) RandomBytes(ctx context.Context, size int) (string, error) {
+	q := r.query.Select("randomBytes")
+	q = q.Arg("size", size)
+
+	var response string
+
+	q = q.Bind(&response)
+	return response, q.Execute(ctx)
+}
+
 // SecretOpts contains options for Client.Secret
 type SecretOpts struct {
 	Accessor string
@@ -5993,6 +6072,16 @@ func (r *Client) TypeDef() *TypeDef {
 	}
 }
 
+// Returns a new random (version 4) UUID.
+func (r *Client) UUID(ctx context.Context) (string, error) {
+	q := r.query.Select("uuid")
+
+	var response string
+
+	q = q.Bind(&response)
+	return response, q.Execute(ctx)
+}
+
 // A reference to a secret value, which can be handled more safely than the value itself.
 type Secret struct {
 	query *querybuilder.Selection
@@ -21,4 +21,34 @@ public class DaggerQueryException extends Exception {
   public GraphQLError[] getErrors() {
     return errors;
   }
+
+  /**
+   * Returns the {@link ErrorCode} of the first error, or null if the engine sent none or the code
+   * isn't known to this version of the SDK.
+   *
+   * @see #getErrorCodeName()
+   */
+  public ErrorCode getErrorCode() {
+    String name = getErrorCodeName();
+    if (name == null) {
+      return null;
+    }
+    try {
+      return ErrorCode.valueOf(name);
+    } catch (IllegalArgumentException e) {
+      return null;
+    }
+  }
+
+  /**
+   * Returns the machine-readable code of the first error as sent by the engine, such as
+   * "EXEC_ERROR" or "MODULE_NOT_FOUND", or null if the engine sent none.
+   */
+  public String getErrorCodeName() {
+    if (errors.length == 0 || errors[0].getExtensions() == null) {
+      return null;
+    }
+    Object code = errors[0].getExtensions().get("_type");
+    return code instanceof String ? (String) code : null;
+  }
 }
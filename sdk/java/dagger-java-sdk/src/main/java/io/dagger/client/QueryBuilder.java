@@ -94,8 +94,6 @@ class QueryBuilder {
     if (response.getErrors().isEmpty()) {
       throw new DaggerQueryException();
     }
-    // GraphQLError error = response.getErrors().get(0);
-    // error.getExtensions().get("_type");
     throw new DaggerQueryException(response.getErrors().toArray(new GraphQLError[0]));
   }
 
@@ -80,6 +80,7 @@ public class ClientIT {
       } catch (DaggerQueryException dqe) {
         assertThat(dqe.getErrors()).hasSizeGreaterThan(0);
         assertThat(dqe.getErrors()[0].getExtensions()).containsEntry("_type", "EXEC_ERROR");
+        assertThat(dqe.getErrorCode()).isEqualTo(ErrorCode.EXEC_ERROR);
       }
     }
   }
@@ -0,0 +1,49 @@
+package io.dagger.client;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.when;
+
+import io.smallrye.graphql.client.GraphQLError;
+import java.util.Map;
+import org.junit.jupiter.api.Test;
+
+public class DaggerQueryExceptionTest {
+
+  @Test
+  public void error_code_is_read_from_extensions() {
+    Map<String, ErrorCode> cases =
+        Map.of(
+            "MODULE_NOT_FOUND", ErrorCode.MODULE_NOT_FOUND,
+            "TIMEOUT", ErrorCode.TIMEOUT);
+    cases.forEach(
+        (name, code) -> {
+          DaggerQueryException e = new DaggerQueryException(errorWithCode(name));
+          assertThat(e.getErrorCode()).isEqualTo(code);
+          assertThat(e.getErrorCodeName()).isEqualTo(name);
+        });
+  }
+
+  @Test
+  public void unknown_error_code_is_only_available_by_name() {
+    DaggerQueryException e = new DaggerQueryException(errorWithCode("SOMETHING_NEW"));
+    assertThat(e.getErrorCode()).isNull();
+    assertThat(e.getErrorCodeName()).isEqualTo("SOMETHING_NEW");
+  }
+
+  @Test
+  public void error_code_is_null_without_extension() {
+    GraphQLError error = mock(GraphQLError.class);
+    when(error.getMessage()).thenReturn("oops");
+    assertThat(new DaggerQueryException(error).getErrorCode()).isNull();
+    assertThat(new DaggerQueryException(error).getErrorCodeName()).isNull();
+    assertThat(new DaggerQueryException().getErrorCode()).isNull();
+  }
+
+  private static GraphQLError errorWithCode(String code) {
+    GraphQLError error = mock(GraphQLError.class);
+    when(error.getMessage()).thenReturn("oops");
+    when(error.getExtensions()).thenReturn(Map.of("_type", code));
+    return error;
+  }
+}
@@ -558,6 +558,15 @@ class Client extends Client\AbstractClient
         return new \Dagger\ModuleSource($this->client, $this->queryBuilderChain->chain($innerQueryBuilder));
     }
 
+    /**
+     * Returns the current time on the engine, formatted as an RFC 3339 timestamp.
+     */
+    public function now(): string
+    {
+        $leafQueryBuilder = new \Dagger\Client\QueryBuilder('now');
+        return (string)$this->queryLeaf($leafQueryBuilder, 'now');
+    }
+
     /**
      * Creates a named sub-pipeline.
      */
@@ -574,6 +583,16 @@ class Client extends Client\AbstractClient
         return new \Dagger\Client($this->client, $this->queryBuilderChain->chain($innerQueryBuilder));
     }
 
+    /**
+     * Returns cryptographically secure random bytes, encoded as standard base64.
+     */
+    public function randomBytes(int $size): string
+    {
+        $leafQueryBuilder = new \Dagger\Client\QueryBuilder('randomBytes');
+        $leafQueryBuilder->setArgument('size', $size);
+        return (string)$this->queryLeaf($leafQueryBuilder, 'randomBytes');
+    }
+
     /**
      * Reference a secret by name.
      */
@@ -618,4 +637,13 @@ class Client extends Client\AbstractClient
         $innerQueryBuilder = new \Dagger\Client\QueryBuilder('typeDef');
         return new \Dagger\TypeDef($this->client, $this->queryBuilderChain->chain($innerQueryBuilder));
     }
+
+    /**
+     * Returns a new random (version 4) UUID.
+     */
+    public function uuid(): string
+    {
+        $leafQueryBuilder = new \Dagger\Client\QueryBuilder('uuid');
+        return (string)$this->queryLeaf($leafQueryBuilder, 'uuid');
+    }
 }
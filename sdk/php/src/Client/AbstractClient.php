@@ -4,8 +4,10 @@ namespace Dagger\Client;
 
 use Dagger\Client;
 use Dagger\Connection;
+use Dagger\Exception\QueryError;
 use Dagger\GraphQl\QueryBuilderChain;
 use GraphQL\Client as GqlClient;
+use GraphQL\Exception\QueryError as GqlQueryError;
 use GraphQL\Query;
 use GraphQL\QueryBuilder\QueryBuilder;
 use GraphQL\Results;
@@ -30,14 +32,21 @@ abstract class AbstractClient
         $this->client = $this;
     }
 
+    /**
+     * @throws QueryError
+     */
     public function runQuery(QueryBuilder|Query $query): Results
     {
-        return $this->graphQlClient->runQuery($query);
+        try {
+            return $this->graphQlClient->runQuery($query);
+        } catch (GqlQueryError $e) {
+            throw QueryError::fromGraphQl($e);
+        }
     }
 
     public function queryLeaf(QueryBuilder|Query $query, string $leafKey): null|array|string|int|float|bool
     {
-        $response = $this->graphQlClient->runQuery($query);
+        $response = $this->runQuery($query);
         $data = $response->getData();
         foreach (new RecursiveIteratorIterator(
             new RecursiveArrayIterator($data), RecursiveIteratorIterator::CHILD_FIRST) as $k => $value) {
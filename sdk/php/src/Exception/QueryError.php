@@ -0,0 +1,57 @@
+<?php
+
+namespace Dagger\Exception;
+
+use Dagger\ErrorCode;
+use GraphQL\Exception\QueryError as GqlQueryError;
+use RuntimeException;
+
+/**
+ * The engine returned an error for a query.
+ */
+class QueryError extends RuntimeException
+{
+    /**
+     * @param array<string, mixed> $errorDetails the first error of the response
+     */
+    public function __construct(
+        private readonly array $errorDetails,
+        ?GqlQueryError $previous = null
+    ) {
+        parent::__construct($errorDetails['message'] ?? '', 0, $previous);
+    }
+
+    public static function fromGraphQl(GqlQueryError $error): self
+    {
+        return new self($error->getErrorDetails(), $error);
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function getErrorDetails(): array
+    {
+        return $this->errorDetails;
+    }
+
+    /**
+     * Returns the error code, or null if the engine sent none or it isn't
+     * known to this version of the SDK.
+     */
+    public function getErrorCode(): ?ErrorCode
+    {
+        $name = $this->getErrorCodeName();
+
+        return $name === null ? null : ErrorCode::tryFrom($name);
+    }
+
+    /**
+     * Returns the error code as sent by the engine, such as "EXEC_ERROR".
+     */
+    public function getErrorCodeName(): ?string
+    {
+        $code = $this->errorDetails['extensions']['_type'] ?? null;
+
+        return is_string($code) ? $code : null;
+    }
+}
@@ -0,0 +1,38 @@
+<?php
+
+namespace Dagger\Tests\Exception;
+
+use Dagger\ErrorCode;
+use Dagger\Exception\QueryError;
+use GraphQL\Exception\QueryError as GqlQueryError;
+use PHPUnit\Framework\TestCase;
+
+class QueryErrorTest extends TestCase
+{
+    public function testErrorCode(): void
+    {
+        $cases = [
+            'MODULE_NOT_FOUND' => ErrorCode::MODULE_NOT_FOUND,
+            'TIMEOUT' => ErrorCode::TIMEOUT,
+            'SOMETHING_NEW' => null,
+        ];
+
+        foreach ($cases as $name => $code) {
+            $error = QueryError::fromGraphQl(new GqlQueryError([
+                'errors' => [['message' => 'oops', 'extensions' => ['_type' => $name]]],
+            ]));
+
+            $this->assertSame('oops', $error->getMessage());
+            $this->assertSame($code, $error->getErrorCode());
+            $this->assertSame($name, $error->getErrorCodeName());
+        }
+    }
+
+    public function testNoErrorCode(): void
+    {
+        $error = new QueryError(['message' => 'oops']);
+
+        $this->assertNull($error->getErrorCode());
+        $this->assertNull($error->getErrorCodeName());
+    }
+}
@@ -1,10 +1,13 @@
 from dataclasses import dataclass, field
-from typing import Any
+from typing import TYPE_CHECKING, Any
 
 import cattrs
 import graphql
 from gql.transport.exceptions import TransportQueryError
 
+if TYPE_CHECKING:
+    from dagger.client.gen import ErrorCode
+
 
 class VersionMismatch(Warning):
     """Dagger CLI version doesn't match required version."""
@@ -80,7 +83,15 @@ class QueryErrorValue:
 
 
 class QueryError(ClientError):
-    """The server returned an error for a specific query."""
+    """The server returned an error for a specific query.
+
+    Attributes
+    ----------
+    code:
+        The machine-readable :py:class:`ErrorCode` of the error, if the
+        API sent one. Codes that aren't known to this version of the SDK
+        are kept as plain strings.
+    """
 
     _type = None
 
@@ -103,6 +114,7 @@ class QueryError(ClientError):
         super().__init__(errors[0])
         self.errors: list[QueryErrorValue] = errors
         self.query = query
+        self.code: "ErrorCode | str | None" = _error_code(errors[0])
 
     def debug_query(self):
         """Return GraphQL query for debugging purposes.
@@ -132,6 +144,19 @@ class QueryError(ClientError):
         return "\n".join(res)
 
 
+def _error_code(error: QueryErrorValue) -> "ErrorCode | str | None":
+    # Imported here since the generated client depends on this module.
+    from dagger.client.gen import ErrorCode
+
+    code = error.extensions.get("_type")
+    if code is None:
+        return None
+    try:
+        return ErrorCode(code)
+    except ValueError:
+        return code
+
+
 def _query_error_from_transport(exc: TransportQueryError, query: graphql.DocumentNode):
     """Create instance from a gql exception."""
     try:
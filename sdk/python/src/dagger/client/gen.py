@@ -197,6 +197,26 @@ class CacheSharingMode(Enum):
     """Shares the cache volume amongst many build pipelines"""
 
 
+class ErrorCode(Enum):
+    """A machine-readable code for a class of API errors, sent in the "_type"
+    extension of GraphQL errors."""
+
+    CANCELED = "CANCELED"
+    """The request was canceled before it completed."""
+
+    CLIENT_CLOSED = "CLIENT_CLOSED"
+    """The session of the client making the request was already closed."""
+
+    EXEC_ERROR = "EXEC_ERROR"
+    """A command executed in a container failed. The error also has "cmd", "exitCode", "stdout" and "stderr" extensions."""
+
+    MODULE_NOT_FOUND = "MODULE_NOT_FOUND"
+    """A module, or one of its dependencies, does not exist at the given source."""
+
+    TIMEOUT = "TIMEOUT"
+    """The request exceeded its deadline."""
+
+
 class ImageLayerCompression(Enum):
     """Compression algorithm to use for image layers."""
 
@@ -6159,6 +6179,29 @@ class Client(Root):
         _ctx = self._select("moduleSource", _args)
         return ModuleSource(_ctx)
 
+    @typecheck
+    async def now(self) -> str:
+        """Returns the current time on the engine, formatted as an RFC 3339
+        timestamp.
+
+        Returns
+        -------
+        str
+            The `String` scalar type represents textual data, represented as
+            UTF-8 character sequences. The String type is most often used by
+            GraphQL to represent free-form human-readable text.
+
+        Raises
+        ------
+        ExecuteTimeoutError
+            If the time to execute the query exceeds the configured timeout.
+        QueryError
+            If the API returns an error.
+        """
+        _args: list[Arg] = []
+        _ctx = self._select("now", _args)
+        return await _ctx.execute(str)
+
     @typecheck
     def pipeline(
         self,
@@ -6186,6 +6229,36 @@ class Client(Root):
         _ctx = self._select("pipeline", _args)
         return Client(_ctx)
 
+    @typecheck
+    async def random_bytes(self, size: int) -> str:
+        """Returns cryptographically secure random bytes, encoded as standard
+        base64.
+
+        Parameters
+        ----------
+        size:
+            Number of random bytes to generate, up to 65536.
+
+        Returns
+        -------
+        str
+            The `String` scalar type represents textual data, represented as
+            UTF-8 character sequences. The String type is most often used by
+            GraphQL to represent free-form human-readable text.
+
+        Raises
+        ------
+        ExecuteTimeoutError
+            If the time to execute the query exceeds the configured timeout.
+        QueryError
+            If the API returns an error.
+        """
+        _args = [
+            Arg("size", size),
+        ]
+        _ctx = self._select("randomBytes", _args)
+        return await _ctx.execute(str)
+
     @typecheck
     def secret(
         self,
@@ -6247,6 +6320,28 @@ class Client(Root):
         _ctx = self._select("typeDef", _args)
         return TypeDef(_ctx)
 
+    @typecheck
+    async def uuid(self) -> str:
+        """Returns a new random (version 4) UUID.
+
+        Returns
+        -------
+        str
+            The `String` scalar type represents textual data, represented as
+            UTF-8 character sequences. The String type is most often used by
+            GraphQL to represent free-form human-readable text.
+
+        Raises
+        ------
+        ExecuteTimeoutError
+            If the time to execute the query exceeds the configured timeout.
+        QueryError
+            If the API returns an error.
+        """
+        _args: list[Arg] = []
+        _ctx = self._select("uuid", _args)
+        return await _ctx.execute(str)
+
     def with_(self, cb: Callable[["Client"], "Client"]) -> "Client":
         """Call the provided callable with current Client.
 
@@ -6852,6 +6947,7 @@ __all__ = [
     "DirectoryID",
     "EnvVariable",
     "EnvVariableID",
+    "ErrorCode",
     "FieldTypeDef",
     "FieldTypeDefID",
     "File",
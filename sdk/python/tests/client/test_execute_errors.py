@@ -98,6 +98,33 @@ async def test_no_path_query_error(client: dagger.Client, httpx_mock: HTTPXMock)
     assert msg in str(exc)
     assert exc.errors[0].path is None
     assert exc.errors[0].locations is None
+    assert exc.code is None
+
+
+@pytest.mark.parametrize(
+    ("error_type", "code"),
+    [
+        ("MODULE_NOT_FOUND", dagger.ErrorCode.MODULE_NOT_FOUND),
+        ("TIMEOUT", dagger.ErrorCode.TIMEOUT),
+        ("SOMETHING_NEW", "SOMETHING_NEW"),
+    ],
+)
+async def test_query_error_code(
+    error_type: str,
+    code: dagger.ErrorCode | str,
+    client: dagger.Client,
+    httpx_mock: HTTPXMock,
+):
+    error = {
+        "message": "oops",
+        "extensions": {"_type": error_type},
+    }
+    httpx_mock.add_response(json={"errors": [error]})
+
+    with pytest.raises(dagger.QueryError) as exc_info:
+        await client.container().from_("alpine")
+
+    assert exc_info.value.code == code
 
 
 @pytest.mark.slow()
@@ -122,6 +149,7 @@ async def test_exec_error(client: dagger.Client, httpx_mock: HTTPXMock):
 
     exc = exc_info.value
     assert issubclass(exc.__class__, dagger.QueryError)
+    assert exc.code == dagger.ErrorCode.EXEC_ERROR
 
     assert exc.message == "command not found"
     assert exc.command == ["sh", "-c", "spam"]
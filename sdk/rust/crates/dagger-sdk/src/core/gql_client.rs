@@ -17,7 +17,7 @@ pub struct GraphQLError {
 pub struct GraphQLErrorMessage {
     pub message: String,
     locations: Option<Vec<GraphQLErrorLocation>>,
-    extensions: Option<HashMap<String, String>>,
+    extensions: Option<HashMap<String, serde_json::Value>>,
     path: Option<Vec<GraphQLErrorPathParam>>,
 }
 
@@ -35,6 +35,14 @@ pub enum GraphQLErrorPathParam {
     Number(u32),
 }
 
+impl GraphQLErrorMessage {
+    /// The machine-readable error code sent by the engine, if any, e.g.
+    /// "EXEC_ERROR" or "MODULE_NOT_FOUND".
+    pub fn code(&self) -> Option<&str> {
+        self.extensions.as_ref()?.get("_type")?.as_str()
+    }
+}
+
 impl GraphQLError {
     pub fn with_text(message: impl AsRef<str>) -> Self {
         Self {
@@ -55,8 +55,10 @@ fn map_graphql_error(gql_error: crate::core::gql_client::GraphQLError) -> GraphQ
 
     if let Some(json) = json {
         if !json.is_empty() {
+            let code = json.first().and_then(|e| e.code()).map(str::to_string);
             return GraphQLError::DomainError {
                 message,
+                code,
                 fields: GraphqlErrorMessages(json.into_iter().map(|e| e.message).collect()),
             };
         }
@@ -72,10 +74,23 @@ pub enum GraphQLError {
     #[error("domain error:\n{message}\n{fields}")]
     DomainError {
         message: String,
+        /// The machine-readable code of the first error, if the engine sent
+        /// one, e.g. "EXEC_ERROR" or "MODULE_NOT_FOUND".
+        code: Option<String>,
         fields: GraphqlErrorMessages,
     },
 }
 
+impl GraphQLError {
+    /// Returns the machine-readable error code sent by the engine, if any.
+    pub fn code(&self) -> Option<&str> {
+        match self {
+            GraphQLError::DomainError { code, .. } => code.as_deref(),
+            GraphQLError::HttpError(_) => None,
+        }
+    }
+}
+
 #[derive(Debug, Clone)]
 pub struct GraphqlErrorMessages(Vec<String>);
 
@@ -88,3 +103,53 @@ impl std::fmt::Display for GraphqlErrorMessages {
         Ok(())
     }
 }
+
+#[cfg(test)]
+mod test {
+    use super::*;
+    use crate::core::gql_client::GraphQLErrorMessage;
+
+    fn domain_error(errors: serde_json::Value) -> GraphQLError {
+        let json: Vec<GraphQLErrorMessage> = serde_json::from_value(errors).unwrap();
+        map_graphql_error(crate::core::gql_client::GraphQLError::with_json(json))
+    }
+
+    #[test]
+    fn test_query_error_code() {
+        for code in ["MODULE_NOT_FOUND", "TIMEOUT", "SOMETHING_NEW"] {
+            let err = domain_error(serde_json::json!([
+                {"message": "oops", "extensions": {"_type": code}}
+            ]));
+            assert_eq!(err.code(), Some(code));
+        }
+
+        let err = domain_error(serde_json::json!([{
+            "message": "exit code 1",
+            "extensions": {"_type": "EXEC_ERROR", "exitCode": 1, "cmd": ["false"]}
+        }]));
+        assert_eq!(err.code(), Some("EXEC_ERROR"));
+
+        let err = domain_error(serde_json::json!([{"message": "oops"}]));
+        assert_eq!(err.code(), None);
+    }
+
+    #[test]
+    #[cfg(feature = "gen")]
+    fn test_dagger_error_code() {
+        use crate::errors::DaggerError;
+        use crate::ErrorCode;
+
+        let cases = [
+            ("MODULE_NOT_FOUND", Some(ErrorCode::ModuleNotFound)),
+            ("TIMEOUT", Some(ErrorCode::Timeout)),
+            ("SOMETHING_NEW", None),
+        ];
+        for (name, code) in cases {
+            let err = DaggerError::Query(domain_error(serde_json::json!([
+                {"message": "oops", "extensions": {"_type": name}}
+            ])));
+            assert_eq!(err.error_code(), code);
+            assert_eq!(err.code(), Some(name));
+        }
+    }
+}
@@ -20,6 +20,26 @@ pub enum DaggerError {
     DownloadClient(#[source] eyre::Error),
 }
 
+impl DaggerError {
+    /// Returns the machine-readable error code sent by the engine, if any,
+    /// e.g. "EXEC_ERROR" or "MODULE_NOT_FOUND".
+    pub fn code(&self) -> Option<&str> {
+        match self {
+            DaggerError::Query(e) => e.code(),
+            _ => None,
+        }
+    }
+
+    /// Returns the error code sent by the engine as a [`crate::ErrorCode`],
+    /// or `None` if there is none or it isn't known to this version of the
+    /// SDK, in which case it's still available from [`DaggerError::code`].
+    #[cfg(feature = "gen")]
+    pub fn error_code(&self) -> Option<crate::ErrorCode> {
+        let code = self.code()?;
+        serde_json::from_value(serde_json::Value::String(code.to_string())).ok()
+    }
+}
+
 #[derive(Error, Debug)]
 pub enum DaggerUnpackError {
     #[error("Too many nested objects inside graphql response")]
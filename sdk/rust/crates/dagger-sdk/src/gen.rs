@@ -5746,6 +5746,11 @@ impl Query {
             graphql_client: self.graphql_client.clone(),
         }
     }
+    /// Returns the current time on the engine, formatted as an RFC 3339 timestamp.
+    pub async fn now(&self) -> Result<String, DaggerError> {
+        let query = self.selection.select("now");
+        query.execute(self.graphql_client.clone()).await
+    }
     /// Creates a named sub-pipeline.
     ///
     /// # Arguments
@@ -5782,6 +5787,16 @@ impl Query {
             graphql_client: self.graphql_client.clone(),
         }
     }
+    /// Returns cryptographically secure random bytes, encoded as standard base64.
+    ///
+    /// # Arguments
+    ///
+    /// * `size` - Number of random bytes to generate, up to 65536.
+    pub async fn random_bytes(&self, size: isize) -> Result<String, DaggerError> {
+        let mut query = self.selection.select("randomBytes");
+        query = query.arg("size", size);
+        query.execute(self.graphql_client.clone()).await
+    }
     /// Reference a secret by name.
     ///
     /// # Arguments
@@ -5855,6 +5870,11 @@ impl Query {
             graphql_client: self.graphql_client.clone(),
         }
     }
+    /// Returns a new random (version 4) UUID.
+    pub async fn uuid(&self) -> Result<String, DaggerError> {
+        let query = self.selection.select("uuid");
+        query.execute(self.graphql_client.clone()).await
+    }
 }
 #[derive(Clone)]
 pub struct Secret {
@@ -6323,6 +6343,19 @@ pub enum CacheSharingMode {
     Shared,
 }
 #[derive(Serialize, Deserialize, Clone, PartialEq, Debug)]
+pub enum ErrorCode {
+    #[serde(rename = "CANCELED")]
+    Canceled,
+    #[serde(rename = "CLIENT_CLOSED")]
+    ClientClosed,
+    #[serde(rename = "EXEC_ERROR")]
+    ExecError,
+    #[serde(rename = "MODULE_NOT_FOUND")]
+    ModuleNotFound,
+    #[serde(rename = "TIMEOUT")]
+    Timeout,
+}
+#[derive(Serialize, Deserialize, Clone, PartialEq, Debug)]
 pub enum ImageLayerCompression {
     #[serde(rename = "EStarGZ")]
     EStarGz,
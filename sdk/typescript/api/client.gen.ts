@@ -595,6 +595,35 @@ export type DirectoryID = string & { __DirectoryID: never }
  */
 export type EnvVariableID = string & { __EnvVariableID: never }
 
+/**
+ * A machine-readable code for a class of API errors, sent in the "_type" extension of GraphQL errors.
+ */
+export enum ErrorCode {
+  /**
+   * The request was canceled before it completed.
+   */
+  Canceled = "CANCELED",
+
+  /**
+   * The session of the client making the request was already closed.
+   */
+  ClientClosed = "CLIENT_CLOSED",
+
+  /**
+   * A command executed in a container failed. The error also has "cmd", "exitCode", "stdout" and "stderr" extensions.
+   */
+  ExecError = "EXEC_ERROR",
+
+  /**
+   * A module, or one of its dependencies, does not exist at the given source.
+   */
+  ModuleNotFound = "MODULE_NOT_FOUND",
+
+  /**
+   * The request exceeded its deadline.
+   */
+  Timeout = "TIMEOUT",
+}
 /**
  * The `FieldTypeDefID` scalar type represents an identifier for an object of type FieldTypeDef.
  */
@@ -7706,6 +7735,23 @@ export class Client extends BaseClient {
     })
   }
 
+  /**
+   * Returns the current time on the engine, formatted as an RFC 3339 timestamp.
+   */
+  now = async (): Promise<string> => {
+    const response: Awaited<string> = await computeQuery(
+      [
+        ...this._queryTree,
+        {
+          operation: "now",
+        },
+      ],
+      await this._ctx.connection(),
+    )
+
+    return response
+  }
+
   /**
    * Creates a named sub-pipeline.
    * @param name Name of the sub-pipeline.
@@ -7725,6 +7771,25 @@ export class Client extends BaseClient {
     })
   }
 
+  /**
+   * Returns cryptographically secure random bytes, encoded as standard base64.
+   * @param size Number of random bytes to generate, up to 65536.
+   */
+  randomBytes = async (size: number): Promise<string> => {
+    const response: Awaited<string> = await computeQuery(
+      [
+        ...this._queryTree,
+        {
+          operation: "randomBytes",
+          args: { size },
+        },
+      ],
+      await this._ctx.connection(),
+    )
+
+    return response
+  }
+
   /**
    * Reference a secret by name.
    */
@@ -7793,6 +7858,23 @@ export class Client extends BaseClient {
     })
   }
 
+  /**
+   * Returns a new random (version 4) UUID.
+   */
+  uuid = async (): Promise<string> => {
+    const response: Awaited<string> = await computeQuery(
+      [
+        ...this._queryTree,
+        {
+          operation: "uuid",
+        },
+      ],
+      await this._ctx.connection(),
+    )
+
+    return response
+  }
+
   /**
    * Call the provided function with current Client.
    *
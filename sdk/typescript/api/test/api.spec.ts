@@ -1,9 +1,11 @@
 import assert from "assert"
 import { randomUUID } from "crypto"
 import fs from "fs"
+import { GraphQLError } from "graphql"
 
 import {
   ExecError,
+  GraphQLRequestError,
   TooManyNestedObjectsError,
 } from "../../common/errors/index.js"
 import {
@@ -12,6 +14,7 @@ import {
   connect,
   Container,
   Directory,
+  ErrorCode,
   NetworkProtocol,
 } from "../../index.js"
 import { buildQuery, queryFlatten } from "../utils.js"
@@ -264,6 +267,31 @@ describe("TypeScript SDK api", function () {
     })
   })
 
+  it("Return the error code of a GraphQLRequestError", function () {
+    const cases: [string, string][] = [
+      ["MODULE_NOT_FOUND", ErrorCode.ModuleNotFound],
+      ["TIMEOUT", ErrorCode.Timeout],
+      ["SOMETHING_NEW", "SOMETHING_NEW"],
+    ]
+
+    for (const [type, code] of cases) {
+      const e = new GraphQLRequestError("oops", {
+        request: { query: "{ container { from } }" },
+        response: {
+          status: 200,
+          errors: [new GraphQLError("oops", { extensions: { _type: type } })],
+        },
+      })
+      assert.strictEqual(e.errorCode, code)
+    }
+
+    const e = new GraphQLRequestError("oops", {
+      request: { query: "{ container { from } }" },
+      response: { status: 200, errors: [new GraphQLError("oops")] },
+    })
+    assert.strictEqual(e.errorCode, undefined)
+  })
+
   it("Support container sync", async function () {
     this.timeout(60000)
 
@@ -8,7 +8,7 @@ import {
   NotAwaitedRequestError,
   ExecError,
 } from "../common/errors/index.js"
-import { Metadata, QueryTree } from "./client.gen.js"
+import { ErrorCode, Metadata, QueryTree } from "./client.gen.js"
 
 /**
  * Format argument into GraphQL query format.
@@ -207,7 +207,7 @@ export async function compute<T>(
       const msg = e.response.errors?.[0]?.message ?? `API Error`
       const ext = e.response.errors?.[0]?.extensions
 
-      if (ext?._type === "EXEC_ERROR") {
+      if (ext?._type === ErrorCode.ExecError) {
         throw new ExecError(msg, {
           cmd: (ext.cmd as string[]) ?? [],
           exitCode: (ext.exitCode as number) ?? -1,
@@ -3,6 +3,7 @@ import {
   GraphQLResponse,
 } from "graphql-request/build/esm/types.js"
 
+import type { ErrorCode } from "../../api/client.gen.js"
 import { DaggerSDKError, DaggerSDKErrorOptions } from "./DaggerSDKError.js"
 import { ERROR_CODES, ERROR_NAMES } from "./errors-codes.js"
 
@@ -28,6 +29,13 @@ export class GraphQLRequestError extends DaggerSDKError {
    */
   response: GraphQLResponse
 
+  /**
+   *  The machine-readable code of the error, if the engine sent one.
+   *  Known codes are listed in {@link ErrorCode}; unknown codes are passed
+   *  through as-is.
+   */
+  errorCode?: ErrorCode | string
+
   /**
    *  @hidden
    */
@@ -35,5 +43,10 @@ export class GraphQLRequestError extends DaggerSDKError {
     super(message, options)
     this.requestContext = options.request
     this.response = options.response
+
+    const errorCode = options.response.errors?.[0]?.extensions?._type
+    if (typeof errorCode === "string") {
+      this.errorCode = errorCode
+    }
   }
 }
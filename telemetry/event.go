@@ -30,6 +30,7 @@ const (
 const (
 	EventTypeOp        = EventType("op")
 	EventTypeLog       = EventType("log")
+	EventTypeTask      = EventType("task")
 	EventTypeAnalytics = EventType("analytics")
 )
 
@@ -66,3 +67,21 @@ type LogPayload struct {
 
 func (LogPayload) Type() EventType   { return EventTypeLog }
 func (LogPayload) Scope() EventScope { return EventScopeRun }
+
+var _ Payload = TaskPayload{}
+
+// TaskPayload reports fine-grained progress within an op, e.g. bytes
+// transferred for a file export or a layer being pushed.
+type TaskPayload struct {
+	OpID string `json:"op_id"`
+	Name string `json:"name"`
+
+	Current int64 `json:"current"`
+	Total   int64 `json:"total"`
+
+	Started   *time.Time `json:"started"`
+	Completed *time.Time `json:"completed"`
+}
+
+func (TaskPayload) Type() EventType   { return EventTypeTask }
+func (TaskPayload) Scope() EventScope { return EventScopeRun }
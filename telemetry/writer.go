@@ -16,6 +16,11 @@ type writer struct {
 	// vertex yet.
 	emittedMemberships map[vertexMembership]bool
 
+	// emittedTasks keeps track of the last TaskPayload emitted for each running
+	// task, so that repeated updates without any progress aren't emitted again.
+	// Tasks are forgotten once they complete.
+	emittedTasks map[vertexTask]TaskPayload
+
 	mu sync.Mutex
 }
 
@@ -24,11 +29,17 @@ type vertexMembership struct {
 	groupID  string
 }
 
+type vertexTask struct {
+	vertexID string
+	name     string
+}
+
 func NewWriter(t *Telemetry) progrock.Writer {
 	return &writer{
 		telemetry:          t,
 		pipeliner:          NewPipeliner(),
 		emittedMemberships: map[vertexMembership]bool{},
+		emittedTasks:       map[vertexTask]TaskPayload{},
 	}
 }
 
@@ -66,6 +77,10 @@ func (t *writer) WriteStatus(ev *progrock.StatusUpdate) error {
 		}, l.Timestamp.AsTime())
 	}
 
+	for _, task := range ev.Tasks {
+		t.maybeEmitTask(ts, taskPayload(task))
+	}
+
 	return nil
 }
 
@@ -104,6 +119,31 @@ func (t *writer) maybeEmitOp(ts time.Time, v *PipelinedVertex, isUpdated bool) {
 	}
 }
 
+// maybeEmitTask emits a TaskPayload unless it is identical to the last one
+// emitted for the same task.
+func (t *writer) maybeEmitTask(ts time.Time, payload TaskPayload) {
+	key := vertexTask{
+		vertexID: payload.OpID,
+		name:     payload.Name,
+	}
+	if last, found := t.emittedTasks[key]; found && sameTask(last, payload) {
+		return
+	}
+	t.telemetry.Push(payload, ts)
+	if payload.Completed != nil {
+		delete(t.emittedTasks, key)
+	} else {
+		t.emittedTasks[key] = payload
+	}
+}
+
+func sameTask(a, b TaskPayload) bool {
+	return a.Current == b.Current &&
+		a.Total == b.Total &&
+		(a.Started == nil) == (b.Started == nil) &&
+		(a.Completed == nil) == (b.Completed == nil)
+}
+
 func (t *writer) vertexOp(v *progrock.Vertex, pl pipeline.Path) OpPayload {
 	op := OpPayload{
 		OpID:     v.Id,
@@ -130,3 +170,24 @@ func (t *writer) vertexOp(v *progrock.Vertex, pl pipeline.Path) OpPayload {
 
 	return op
 }
+
+func taskPayload(task *progrock.VertexTask) TaskPayload {
+	payload := TaskPayload{
+		OpID:    task.Vertex,
+		Name:    task.Name,
+		Current: task.Current,
+		Total:   task.Total,
+	}
+
+	if task.Started != nil {
+		t := task.Started.AsTime()
+		payload.Started = &t
+	}
+
+	if task.Completed != nil {
+		t := task.Completed.AsTime()
+		payload.Completed = &t
+	}
+
+	return payload
+}
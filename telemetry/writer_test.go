@@ -0,0 +1,79 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vito/progrock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTaskPayload(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	completed := started.Add(time.Minute)
+
+	payload := taskPayload(&progrock.VertexTask{
+		Vertex:    "vtx",
+		Name:      "transferring foo",
+		Current:   5,
+		Total:     10,
+		Started:   timestamppb.New(started),
+		Completed: timestamppb.New(completed),
+	})
+	require.Equal(t, "vtx", payload.OpID)
+	require.Equal(t, "transferring foo", payload.Name)
+	require.Equal(t, int64(5), payload.Current)
+	require.Equal(t, int64(10), payload.Total)
+	require.Equal(t, started, *payload.Started)
+	require.Equal(t, completed, *payload.Completed)
+
+	payload = taskPayload(&progrock.VertexTask{
+		Vertex: "vtx",
+		Name:   "transferring foo",
+	})
+	require.Nil(t, payload.Started)
+	require.Nil(t, payload.Completed)
+}
+
+func TestWriterDedupesTasks(t *testing.T) {
+	t.Parallel()
+
+	tel := &Telemetry{enabled: true}
+	w := NewWriter(tel)
+
+	task := func(current int64, completed bool) *progrock.StatusUpdate {
+		vt := &progrock.VertexTask{
+			Vertex:  "vtx",
+			Name:    "transferring foo",
+			Current: current,
+			Total:   10,
+			Started: timestamppb.Now(),
+		}
+		if completed {
+			vt.Completed = timestamppb.Now()
+		}
+		return &progrock.StatusUpdate{Tasks: []*progrock.VertexTask{vt}}
+	}
+
+	for _, ev := range []*progrock.StatusUpdate{
+		task(0, false),
+		task(0, false), // no progress, dropped
+		task(5, false),
+		task(5, false), // no progress, dropped
+		task(10, false),
+		task(10, true),
+	} {
+		require.NoError(t, w.WriteStatus(ev))
+	}
+
+	var currents []int64
+	for _, ev := range tel.queue {
+		require.Equal(t, EventTypeTask, ev.Type)
+		currents = append(currents, ev.Payload.(TaskPayload).Current)
+	}
+	require.Equal(t, []int64{0, 5, 10, 10}, currents)
+	require.Empty(t, w.(*writer).emittedTasks)
+}